@@ -0,0 +1,99 @@
+package game
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+)
+
+//go:embed dicts
+var builtinDicts embed.FS
+
+// Dictionary supplies the word list a game is played against: the
+// possible answers, the words accepted as guesses, and how many letters
+// a guess must be.
+type Dictionary interface {
+	Answers() []string
+	Guesses() map[string]struct{}
+	WordLength() int
+}
+
+type wordListDictionary struct {
+	answers    []string
+	guesses    map[string]struct{}
+	wordLength int
+}
+
+func (d *wordListDictionary) Answers() []string            { return d.answers }
+func (d *wordListDictionary) Guesses() map[string]struct{} { return d.guesses }
+func (d *wordListDictionary) WordLength() int              { return d.wordLength }
+
+// BuiltinDictionary loads one of the word lists embedded under
+// dicts/<lang>, e.g. "en", "es", "de", "fr".
+func BuiltinDictionary(lang string) (Dictionary, error) {
+	sub, err := fs.Sub(builtinDicts, path.Join("dicts", lang))
+	if err != nil {
+		return nil, fmt.Errorf("unknown language %q", lang)
+	}
+	dict, err := loadDictionary(sub, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unknown language %q: %w", lang, err)
+	}
+	return dict, nil
+}
+
+// FileDictionary loads a user-supplied answers.txt/guesses.txt pair from
+// dir. wordLength validates the list and sizes the board; 0 infers it
+// from the length of the first answer.
+func FileDictionary(dir string, wordLength int) (Dictionary, error) {
+	return loadDictionary(os.DirFS(dir), wordLength)
+}
+
+func loadDictionary(fsys fs.FS, wordLength int) (Dictionary, error) {
+	answers, err := readWords(fsys, "answers.txt")
+	if err != nil {
+		return nil, fmt.Errorf("reading answers: %w", err)
+	}
+	guessList, err := readWords(fsys, "guesses.txt")
+	if err != nil {
+		return nil, fmt.Errorf("reading guesses: %w", err)
+	}
+
+	if wordLength == 0 && len(answers) > 0 {
+		wordLength = len([]rune(answers[0]))
+	}
+	for _, word := range answers {
+		if len([]rune(word)) != wordLength {
+			return nil, fmt.Errorf("answer %q is not %d letters long", word, wordLength)
+		}
+	}
+	for _, word := range guessList {
+		if len([]rune(word)) != wordLength {
+			return nil, fmt.Errorf("guess %q is not %d letters long", word, wordLength)
+		}
+	}
+
+	guesses := make(map[string]struct{}, len(guessList))
+	for _, word := range guessList {
+		guesses[word] = struct{}{}
+	}
+	return &wordListDictionary{answers: answers, guesses: guesses, wordLength: wordLength}, nil
+}
+
+func readWords(fsys fs.FS, name string) ([]string, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var words []string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		words = append(words, strings.ToUpper(s.Text()))
+	}
+	return words, s.Err()
+}
@@ -0,0 +1,226 @@
+// Package game holds the core Wordle rules: picking the word for a given
+// day, scoring a guess against the answer, and tracking the state of a
+// single game in progress. It has no knowledge of how that state is
+// displayed.
+package game
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// FirstDay is the day the original Wordle launched. Day numbers are
+// counted relative to it, in UTC to avoid DST shifting a day's answer.
+var FirstDay = time.Date(2021, time.June, 19, 0, 0, 0, 0, time.UTC)
+
+// Eval is the per-letter result of scoring a guess against an answer.
+type Eval int
+
+const (
+	Black Eval = iota
+	Yellow
+	Green
+)
+
+// Cell is a single letter slot on the board.
+type Cell struct {
+	Letter string
+	Eval   Eval
+}
+
+type Hints struct {
+	Yellow map[rune]bool
+	Green  []rune
+}
+
+type Game struct {
+	Day              int
+	WordLength       int
+	CurrentTurn      int
+	TurnsRemaining   int
+	Complete         bool
+	Won              bool
+	Answer           string
+	ValidGuesses     map[string]struct{}
+	SubmittedGuesses map[string]struct{}
+	HardMode         bool
+	Hints            Hints
+	// Keyboard is the best-known status of every letter that's appeared
+	// in a guess so far (green > yellow > black), for rendering an
+	// at-a-glance keyboard hint. Letters not yet guessed are absent.
+	Keyboard map[rune]Eval
+	Board    [][]Cell
+	Valid    *regexp.Regexp
+}
+
+// New starts a game for the given day, drawing the answer and valid
+// guesses from dict. It reports an error if day falls outside dict's
+// answer list.
+func New(day int, hardMode bool, dict Dictionary) (*Game, error) {
+	answers := dict.Answers()
+	if day < 0 || day >= len(answers) {
+		return nil, fmt.Errorf("day %d is out of range: dictionary has %d answers", day, len(answers))
+	}
+
+	wordLength := dict.WordLength()
+	b := make([][]Cell, 6)
+	for i := range b {
+		b[i] = make([]Cell, wordLength)
+		for j := range b[i] {
+			b[i][j] = Cell{
+				Eval:   Black,
+				Letter: "_",
+			}
+		}
+	}
+	return &Game{
+		Day:            day,
+		WordLength:     wordLength,
+		CurrentTurn:    0,
+		TurnsRemaining: 6,
+		Complete:       false,
+		Won:            false,
+		HardMode:       hardMode,
+		Hints: Hints{
+			Yellow: make(map[rune]bool),
+			Green:  make([]rune, wordLength),
+		},
+		Answer:           answers[day],
+		ValidGuesses:     dict.Guesses(),
+		SubmittedGuesses: make(map[string]struct{}),
+		Keyboard:         make(map[rune]Eval),
+		Board:            b,
+		Valid:            validPattern(wordLength),
+	}, nil
+}
+
+// AlreadyGuessed reports whether guess has already been submitted this
+// game.
+func (g *Game) AlreadyGuessed(guess string) bool {
+	_, ok := g.SubmittedGuesses[guess]
+	return ok
+}
+
+// validPattern matches exactly wordLength letters. \p{L} covers any
+// Unicode letter, not just A-Z, so accented letters in non-English
+// dictionaries can be typed and guessed.
+func validPattern(wordLength int) *regexp.Regexp {
+	return regexp.MustCompile(fmt.Sprintf(`^[\p{L}]{%d}$`, wordLength))
+}
+
+func (g *Game) CheckHints(guess string) error {
+	guessRunes := []rune(guess)
+
+	// yellow letters must be present
+	for letter := range g.Hints.Yellow {
+		if !strings.ContainsRune(guess, letter) {
+			return fmt.Errorf("guess must contain \"%s\"", string(letter))
+		}
+	}
+
+	// green letters must be used in the correct places
+	var emptyRune rune
+	for i, greenHint := range g.Hints.Green {
+		if greenHint != emptyRune && greenHint != guessRunes[i] {
+			return fmt.Errorf("position %d must contain \"%s\"", i, string(greenHint))
+		}
+	}
+
+	return nil
+}
+
+// Score evaluates guess against answer, returning the green/yellow/black
+// result for each letter. Both must already be the same number of
+// uppercase letters; Score does no validation of its own. Comparisons
+// are rune-based so multi-byte letters (e.g. Ñ, Ü, É) score correctly.
+func Score(guess, answer string) []Eval {
+	// A letter is green if it matches by index.
+	// A letter is yellow if
+	//   it isn't green
+	//   it exists somewhere in the word
+	//   the sum of green and yellow letters already claimed is less than the frequency of the letter
+	// Otherwise, it's black.
+	guessRunes := []rune(guess)
+	answerRunes := []rune(answer)
+	evals := make([]Eval, len(answerRunes))
+	freq := make(map[rune]int)
+	for _, r := range answerRunes {
+		freq[r]++
+	}
+	for i, r := range guessRunes {
+		if answerRunes[i] == r {
+			freq[r]--
+			evals[i] = Green
+		}
+	}
+	for i, r := range guessRunes {
+		if evals[i] == Green {
+			continue
+		}
+		if strings.ContainsRune(answer, r) && freq[r] > 0 {
+			freq[r]--
+			evals[i] = Yellow
+		} else {
+			evals[i] = Black
+		}
+	}
+	return evals
+}
+
+// AddGuess scores guess against the answer, updates the board, hints and
+// keyboard, and advances the turn. It does not validate that guess is a
+// legal, not-yet-tried word; callers are expected to have checked that
+// already.
+func (g *Game) AddGuess(guess string) {
+	evals := Score(guess, g.Answer)
+	for i, r := range []rune(guess) {
+		g.Board[g.CurrentTurn][i] = Cell{Letter: string(r), Eval: evals[i]}
+		switch evals[i] {
+		case Green:
+			g.Hints.Green[i] = r
+		case Yellow:
+			g.Hints.Yellow[r] = true
+		}
+		if best, ok := g.Keyboard[r]; !ok || evals[i] > best {
+			g.Keyboard[r] = evals[i]
+		}
+	}
+	g.SubmittedGuesses[guess] = struct{}{}
+	g.TurnsRemaining--
+	g.CurrentTurn++
+	if guess == g.Answer || g.TurnsRemaining == 0 {
+		g.Complete = true
+	}
+	if guess == g.Answer {
+		g.Won = true
+	}
+}
+
+// Replay reconstructs a finished Game by replaying a previously recorded
+// sequence of guesses against the same day's answer.
+func Replay(day int, hardMode bool, guesses []string, dict Dictionary) (*Game, error) {
+	g, err := New(day, hardMode, dict)
+	if err != nil {
+		return nil, err
+	}
+	for _, guess := range guesses {
+		g.AddGuess(guess)
+	}
+	return g, nil
+}
+
+// DaysSinceFirstWordle returns today's day number, suitable as the default
+// --day value.
+func DaysSinceFirstWordle() int {
+	year, month, day := time.Now().Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return int(today.Sub(FirstDay).Hours() / 24)
+}
+
+// RandomDay returns a day number for a randomly selected answer in dict.
+func RandomDay(dict Dictionary) int {
+	return rand.Intn(len(dict.Answers()))
+}
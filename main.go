@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/JeremyLoy/termle/game"
+	"github.com/JeremyLoy/termle/score"
+	"github.com/JeremyLoy/termle/solver"
+	"github.com/JeremyLoy/termle/ui"
+)
+
+var (
+	dayFlag    = flag.Int("day", game.DaysSinceFirstWordle(), "select a specific wordle by day")
+	randomFlag = flag.Bool("random", false, "pick a random wordle")
+	hardFlag   = flag.Bool("hard", false, "play in hard mode (any revealed hints must be used in subsequent guesses)")
+	solveFlag  = flag.Bool("solve", false, "auto-solve against --answer (or --day's Wordle) and print the guess chain")
+	hintFlag   = flag.Bool("hint", false, "interactive solver: enter a guess and its pattern, get ranked suggestions")
+	answerFlag = flag.String("answer", "", "solve for this answer with --solve, instead of --day's Wordle")
+	langFlag   = flag.String("lang", "en", "built-in dictionary to play with (en, es, de, fr)")
+	dictFlag   = flag.String("dict", "", "directory containing a custom answers.txt/guesses.txt pair, instead of --lang")
+	lengthFlag = flag.Int("length", 0, "word length for --dict; 0 infers it from the answer list")
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "stats" {
+		runStats(os.Args[2:])
+		return
+	}
+
+	flag.Parse()
+	rand.Seed(time.Now().UnixNano())
+
+	dict, err := loadDictionary(*langFlag, *dictFlag, *lengthFlag)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	random := *randomFlag
+	var day int
+	if random {
+		day = game.RandomDay(dict)
+	} else {
+		day = *dayFlag
+	}
+
+	if *solveFlag || *hintFlag {
+		runSolver(day, dict)
+		return
+	}
+
+	if !random {
+		if m, ok := replayIfAlreadyPlayed(day, dict); ok {
+			run(m)
+			return
+		}
+	}
+
+	g, err := game.New(day, *hardFlag, dict)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	final := run(ui.New(g))
+	if fm, ok := final.(ui.Model); ok && fm.Game().Complete {
+		if err := score.Append(score.FromGame(fm.Game(), random, dictKey(*langFlag, *dictFlag))); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}
+}
+
+func loadDictionary(lang, dir string, length int) (game.Dictionary, error) {
+	if dir != "" {
+		return game.FileDictionary(dir, length)
+	}
+	return game.BuiltinDictionary(lang)
+}
+
+// dictKey identifies a dictionary chosen via --lang/--dict, so a recorded
+// game can be matched back to the dictionary it was played against.
+func dictKey(lang, dir string) string {
+	if dir != "" {
+		return "dict:" + dir
+	}
+	return "lang:" + lang
+}
+
+// replayIfAlreadyPlayed returns a read-only Model showing today's already
+// completed daily game, if one has been recorded under the same
+// dictionary. A day recorded under a different dictionary is treated as
+// unplayed, since its guesses can't be safely replayed against a
+// different answer.
+func replayIfAlreadyPlayed(day int, dict game.Dictionary) (ui.Model, bool) {
+	records, err := score.Load()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	record, ok := score.FindDaily(records, day, dictKey(*langFlag, *dictFlag), dict.WordLength())
+	if !ok {
+		return ui.Model{}, false
+	}
+	g, err := game.Replay(day, record.HardMode, record.Words(), dict)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	return ui.NewFinished(g, "You've already played today's Wordle. Come back tomorrow!"), true
+}
+
+func run(m ui.Model) tea.Model {
+	final, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	return final
+}
+
+// runStats reports stats for the dictionary selected via its own
+// --lang/--dict/--length flags, so history from other dictionaries isn't
+// mixed into the same streak.
+func runStats(args []string) {
+	fs := flag.NewFlagSet("stats", flag.ExitOnError)
+	lang := fs.String("lang", "en", "built-in dictionary the history was played with (en, es, de, fr)")
+	dict := fs.String("dict", "", "directory of the custom answers.txt/guesses.txt pair the history was played with, instead of --lang")
+	length := fs.Int("length", 0, "word length for --dict; 0 infers it from the answer list")
+	_ = fs.Parse(args)
+
+	d, err := loadDictionary(*lang, *dict, *length)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	records, err := score.Load()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Print(ui.RenderStats(score.Summarize(records, dictKey(*lang, *dict), d.WordLength())))
+}
+
+// runSolver drives either --solve or --hint mode from the terminal.
+func runSolver(day int, dict game.Dictionary) {
+	s := solver.New(dict)
+
+	if *solveFlag {
+		answer := *answerFlag
+		if answer == "" {
+			g, err := game.New(day, false, dict)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			answer = g.Answer
+		}
+		answer = strings.ToUpper(answer)
+		for i, turn := range s.Solve(answer) {
+			fmt.Printf("%d: %s\n", i+1, turn.Guess)
+		}
+		return
+	}
+
+	stdin := bufio.NewScanner(os.Stdin)
+	for {
+		fmt.Printf("%d candidates remain. Top suggestions:\n", len(s.Candidates()))
+		printSuggestions(s.Suggest(5))
+
+		fmt.Print("guess> ")
+		if !stdin.Scan() {
+			return
+		}
+		guess := strings.ToUpper(strings.TrimSpace(stdin.Text()))
+		if guess == "" {
+			return
+		}
+
+		fmt.Print("pattern (B/Y/G)> ")
+		if !stdin.Scan() {
+			return
+		}
+		pattern, err := s.ParsePattern(strings.TrimSpace(stdin.Text()))
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		s.Prune(guess, pattern)
+	}
+}
+
+func printSuggestions(suggestions []solver.Suggestion) {
+	for _, sug := range suggestions {
+		fmt.Printf("  %s (expected remaining: %d)\n", sug.Guess, sug.Expected)
+	}
+}
@@ -0,0 +1,201 @@
+// Package score persists one record per completed game so that players
+// can review their history and streaks across sessions.
+package score
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/JeremyLoy/termle/game"
+)
+
+// Guess is the scored letters of a single turn, as recorded in history.
+type Guess struct {
+	Letters []string    `json:"letters"`
+	Evals   []game.Eval `json:"evals"`
+}
+
+// Record is one completed game. Dict and WordLength identify which
+// dictionary produced it, so a daily game played under one dictionary is
+// never mistaken for the same day number under a different one.
+type Record struct {
+	Day         int       `json:"day"`
+	Dict        string    `json:"dict"`
+	WordLength  int       `json:"wordLength"`
+	Answer      string    `json:"answer"`
+	Won         bool      `json:"won"`
+	Turns       int       `json:"turns"`
+	HardMode    bool      `json:"hardMode"`
+	Random      bool      `json:"random"`
+	Guesses     []Guess   `json:"guesses"`
+	CompletedAt time.Time `json:"completedAt"`
+}
+
+// FromGame builds the Record for a finished game. dictKey identifies the
+// dictionary it was played against (e.g. "lang:en" or "dict:/path/to/list").
+func FromGame(g *game.Game, random bool, dictKey string) Record {
+	guesses := make([]Guess, g.CurrentTurn)
+	for i := 0; i < g.CurrentTurn; i++ {
+		gu := Guess{
+			Letters: make([]string, g.WordLength),
+			Evals:   make([]game.Eval, g.WordLength),
+		}
+		for j, cell := range g.Board[i] {
+			gu.Letters[j] = cell.Letter
+			gu.Evals[j] = cell.Eval
+		}
+		guesses[i] = gu
+	}
+	return Record{
+		Day:         g.Day,
+		Dict:        dictKey,
+		WordLength:  g.WordLength,
+		Answer:      g.Answer,
+		Won:         g.Won,
+		Turns:       g.CurrentTurn,
+		HardMode:    g.HardMode,
+		Random:      random,
+		Guesses:     guesses,
+		CompletedAt: time.Now(),
+	}
+}
+
+// path returns the location of history.json, creating its parent
+// directory if necessary.
+func path() (string, error) {
+	var dir string
+	if runtime.GOOS == "windows" {
+		dir = os.Getenv("APPDATA")
+	} else {
+		dir = os.Getenv("XDG_DATA_HOME")
+		if dir == "" {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return "", err
+			}
+			dir = filepath.Join(home, ".local", "share")
+		}
+	}
+	dir = filepath.Join(dir, "termle")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "history.json"), nil
+}
+
+// Load reads every recorded game, oldest first. A missing history file is
+// not an error; it just means no games have been recorded yet.
+func Load() ([]Record, error) {
+	p, err := path()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(p)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var records []Record
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// Append adds r to the history file.
+func Append(r Record) error {
+	records, err := Load()
+	if err != nil {
+		return err
+	}
+	records = append(records, r)
+	p, err := path()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(p, data, 0o644)
+}
+
+// Words returns the guessed word for each turn, in order, so the game can
+// be replayed.
+func (r Record) Words() []string {
+	words := make([]string, len(r.Guesses))
+	for i, guess := range r.Guesses {
+		words[i] = strings.Join(guess.Letters, "")
+	}
+	return words
+}
+
+// FindDaily returns the recorded daily game for day under the dictionary
+// identified by dictKey/wordLength, if one exists. Random-mode games are
+// never returned, since they don't count toward daily streaks. A record
+// from a different dictionary (or one recorded before dictionary identity
+// was tracked) is treated as not found, so the day can be played fresh
+// instead of replaying guesses against a mismatched answer.
+func FindDaily(records []Record, day int, dictKey string, wordLength int) (Record, bool) {
+	for _, r := range records {
+		if !r.Random && r.Day == day && r.Dict == dictKey && r.WordLength == wordLength {
+			return r, true
+		}
+	}
+	return Record{}, false
+}
+
+// Stats summarizes a player's daily-mode history.
+type Stats struct {
+	Played        int
+	Wins          int
+	CurrentStreak int
+	MaxStreak     int
+	Distribution  [6]int
+}
+
+// Summarize computes Stats from history for the dictionary identified by
+// dictKey/wordLength, ignoring random-mode games and games recorded under
+// a different dictionary; otherwise plays under unrelated dictionaries
+// would be counted together, and one dictionary's games could extend or
+// break another's streak.
+func Summarize(records []Record, dictKey string, wordLength int) Stats {
+	var daily []Record
+	for _, r := range records {
+		if !r.Random && r.Dict == dictKey && r.WordLength == wordLength {
+			daily = append(daily, r)
+		}
+	}
+	sort.Slice(daily, func(i, j int) bool { return daily[i].Day < daily[j].Day })
+
+	var s Stats
+	streak := 0
+	prevDay := -2
+	for _, r := range daily {
+		s.Played++
+		if r.Won {
+			s.Wins++
+			s.Distribution[r.Turns-1]++
+		}
+		if r.Won && r.Day == prevDay+1 {
+			streak++
+		} else if r.Won {
+			streak = 1
+		} else {
+			streak = 0
+		}
+		prevDay = r.Day
+		if streak > s.MaxStreak {
+			s.MaxStreak = streak
+		}
+	}
+	s.CurrentStreak = streak
+	return s
+}
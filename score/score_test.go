@@ -0,0 +1,77 @@
+package score
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindDaily(t *testing.T) {
+	records := []Record{
+		{Day: 5, Dict: "lang:en", WordLength: 5, Won: true},
+		{Day: 5, Dict: "lang:es", WordLength: 5, Won: false},
+		{Day: 6, Dict: "lang:en", WordLength: 5, Random: true},
+	}
+
+	if r, ok := FindDaily(records, 5, "lang:en", 5); !ok || !r.Won {
+		t.Errorf("FindDaily(day 5, lang:en) = %+v, %v, want the English win", r, ok)
+	}
+	if _, ok := FindDaily(records, 5, "lang:de", 5); ok {
+		t.Error("FindDaily(day 5, lang:de) found a record, want none: no game was played under that dictionary")
+	}
+	if _, ok := FindDaily(records, 6, "lang:en", 5); ok {
+		t.Error("FindDaily(day 6, lang:en) found a record, want none: it's a random-mode game")
+	}
+}
+
+func TestWords(t *testing.T) {
+	r := Record{Guesses: []Guess{
+		{Letters: []string{"C", "R", "A", "N", "E"}},
+		{Letters: []string{"T", "R", "A", "C", "E"}},
+	}}
+	want := []string{"CRANE", "TRACE"}
+	if got := r.Words(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Words() = %v, want %v", got, want)
+	}
+}
+
+func TestSummarizeStreaks(t *testing.T) {
+	records := []Record{
+		{Day: 1, Dict: "lang:en", WordLength: 5, Won: true, Turns: 3},
+		{Day: 2, Dict: "lang:en", WordLength: 5, Won: true, Turns: 4},
+		{Day: 3, Dict: "lang:en", WordLength: 5, Won: false, Turns: 6},
+		{Day: 4, Dict: "lang:en", WordLength: 5, Won: true, Turns: 2},
+	}
+
+	s := Summarize(records, "lang:en", 5)
+	if s.Played != 4 {
+		t.Errorf("Played = %d, want 4", s.Played)
+	}
+	if s.Wins != 3 {
+		t.Errorf("Wins = %d, want 3", s.Wins)
+	}
+	if s.CurrentStreak != 1 {
+		t.Errorf("CurrentStreak = %d, want 1 (only day 4 won, after day 3's loss)", s.CurrentStreak)
+	}
+	if s.MaxStreak != 2 {
+		t.Errorf("MaxStreak = %d, want 2 (days 1-2)", s.MaxStreak)
+	}
+	if s.Distribution[2] != 1 || s.Distribution[3] != 1 || s.Distribution[1] != 1 {
+		t.Errorf("Distribution = %v, want one win each in buckets for turns 3, 4, and 2", s.Distribution)
+	}
+}
+
+func TestSummarizeIgnoresOtherDictionariesAndRandomGames(t *testing.T) {
+	records := []Record{
+		{Day: 5, Dict: "lang:en", WordLength: 5, Won: true, Turns: 3},
+		{Day: 5, Dict: "lang:es", WordLength: 5, Won: false, Turns: 6},
+		{Day: 6, Dict: "lang:en", WordLength: 5, Won: true, Turns: 2, Random: true},
+	}
+
+	s := Summarize(records, "lang:en", 5)
+	if s.Played != 1 {
+		t.Errorf("Played = %d, want 1: the es record and the random-mode record shouldn't count", s.Played)
+	}
+	if s.CurrentStreak != 1 {
+		t.Errorf("CurrentStreak = %d, want 1", s.CurrentStreak)
+	}
+}
@@ -0,0 +1,211 @@
+// Package solver implements an assistant mode for termle: given the
+// guesses and patterns seen so far, it prunes the answer set and
+// suggests the guess expected to narrow it down the most.
+package solver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/JeremyLoy/termle/game"
+)
+
+// Pattern is the per-position result a guess produces against some
+// answer, encoded as one B(lack)/Y(ellow)/G(reen) letter per position.
+type Pattern string
+
+func patternOf(evals []game.Eval) Pattern {
+	letters := make([]byte, len(evals))
+	for i, e := range evals {
+		switch e {
+		case game.Green:
+			letters[i] = 'G'
+		case game.Yellow:
+			letters[i] = 'Y'
+		default:
+			letters[i] = 'B'
+		}
+	}
+	return Pattern(letters)
+}
+
+// Opener is the fixed first guess for 5 letter dictionaries. Solve's
+// automatic first turn uses Opener directly rather than ranking the whole
+// guess list. Opener's expected-remaining-candidates value against the
+// full answer set is precomputed when the Solver is built, and Suggest
+// reuses it while no candidates have been pruned; every other guess is
+// still scored by Suggest on each call, including the first.
+const Opener = "SALET"
+
+// Solver narrows a candidate answer set down as guesses are scored.
+type Solver struct {
+	wordLength     int
+	allAnswers     []string
+	candidates     []string
+	guesses        []string
+	openerExpected int
+	openerValid    bool
+}
+
+// New returns a Solver whose candidates are every answer in dict.
+func New(dict game.Dictionary) *Solver {
+	answers := dict.Answers()
+	guessSet := dict.Guesses()
+	guesses := make([]string, 0, len(guessSet))
+	for guess := range guessSet {
+		guesses = append(guesses, guess)
+	}
+	sort.Strings(guesses)
+
+	s := &Solver{
+		wordLength: dict.WordLength(),
+		allAnswers: answers,
+		candidates: answers,
+		guesses:    guesses,
+	}
+	if len(Opener) == s.wordLength {
+		s.openerExpected = expectedRemaining(Opener, answers)
+		s.openerValid = true
+	}
+	return s
+}
+
+// Candidates returns the answers still consistent with every
+// (guess, pattern) seen so far.
+func (s *Solver) Candidates() []string {
+	return s.candidates
+}
+
+// Prune narrows the candidate set to those that would have produced
+// pattern had they been the answer to guess.
+func (s *Solver) Prune(guess string, pattern Pattern) {
+	var remaining []string
+	for _, candidate := range s.candidates {
+		if patternOf(game.Score(guess, candidate)) == pattern {
+			remaining = append(remaining, candidate)
+		}
+	}
+	s.candidates = remaining
+}
+
+// Suggestion is a candidate guess ranked by how much it's expected to
+// narrow down the remaining candidates.
+type Suggestion struct {
+	Guess    string
+	Expected int
+}
+
+// Suggest returns the n guesses expected to leave the fewest remaining
+// candidates, best first. For each guess that's scored, candidates are
+// partitioned by the pattern the guess would produce against them; the
+// guess minimizing sum(bucketSize^2) is expected to narrow the answer
+// down the most. Ties are broken in favor of guesses that are
+// themselves still candidates. Every guess in the dictionary is scored
+// this way on every call, including the first; the one exception is
+// Opener, whose value precomputed in New is used while the candidate set
+// is still the full answer set.
+func (s *Solver) Suggest(n int) []Suggestion {
+	isCandidate := make(map[string]bool, len(s.candidates))
+	for _, c := range s.candidates {
+		isCandidate[c] = true
+	}
+
+	type ranked struct {
+		Suggestion
+		isCandidate bool
+	}
+	all := make([]ranked, len(s.guesses))
+	for i, guess := range s.guesses {
+		expected := s.expectedFor(guess)
+		all[i] = ranked{Suggestion{Guess: guess, Expected: expected}, isCandidate[guess]}
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Expected != all[j].Expected {
+			return all[i].Expected < all[j].Expected
+		}
+		if all[i].isCandidate != all[j].isCandidate {
+			return all[i].isCandidate
+		}
+		return all[i].Guess < all[j].Guess
+	})
+
+	if n > len(all) {
+		n = len(all)
+	}
+	suggestions := make([]Suggestion, n)
+	for i := 0; i < n; i++ {
+		suggestions[i] = all[i].Suggestion
+	}
+	return suggestions
+}
+
+func (s *Solver) expectedFor(guess string) int {
+	if s.openerValid && guess == Opener && len(s.candidates) == len(s.allAnswers) {
+		return s.openerExpected
+	}
+	return expectedRemaining(guess, s.candidates)
+}
+
+func expectedRemaining(guess string, candidates []string) int {
+	buckets := make(map[Pattern]int)
+	for _, candidate := range candidates {
+		buckets[patternOf(game.Score(guess, candidate))]++
+	}
+	sum := 0
+	for _, size := range buckets {
+		sum += size * size
+	}
+	return sum
+}
+
+// ParsePattern parses a pattern such as "BYGBB" (black, yellow, green,
+// black, black) as typed by a user into a Pattern.
+func (s *Solver) ParsePattern(str string) (Pattern, error) {
+	str = strings.ToUpper(str)
+	if len(str) != s.wordLength {
+		return "", fmt.Errorf("pattern must be %d letters of B/Y/G, got %q", s.wordLength, str)
+	}
+	for _, c := range str {
+		if c != 'B' && c != 'Y' && c != 'G' {
+			return "", fmt.Errorf("unknown letter %q in pattern, expected B/Y/G", string(c))
+		}
+	}
+	return Pattern(str), nil
+}
+
+// Turn is one guess of an auto-solved game.
+type Turn struct {
+	Guess   string
+	Pattern Pattern
+}
+
+// Solve plays against answer automatically, starting from Opener (falling
+// back to the top suggestion for dictionaries Opener doesn't fit)
+// and always guessing the top suggestion, until it guesses correctly or
+// runs out of the usual six turns.
+func (s *Solver) Solve(answer string) []Turn {
+	guess := Opener
+	if !s.openerValid {
+		if suggestions := s.Suggest(1); len(suggestions) > 0 {
+			guess = suggestions[0].Guess
+		}
+	}
+
+	var turns []Turn
+	for i := 0; i < 6; i++ {
+		pattern := patternOf(game.Score(guess, answer))
+		turns = append(turns, Turn{Guess: guess, Pattern: pattern})
+		if guess == answer {
+			break
+		}
+		s.Prune(guess, pattern)
+		suggestions := s.Suggest(1)
+		if len(suggestions) == 0 {
+			break
+		}
+		guess = suggestions[0].Guess
+	}
+	return turns
+}
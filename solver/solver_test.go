@@ -0,0 +1,119 @@
+package solver
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/JeremyLoy/termle/game"
+)
+
+// stubDict is a minimal game.Dictionary for testing the solver in
+// isolation from the embedded word lists.
+type stubDict struct {
+	answers    []string
+	guesses    map[string]struct{}
+	wordLength int
+}
+
+func (d stubDict) Answers() []string            { return d.answers }
+func (d stubDict) Guesses() map[string]struct{} { return d.guesses }
+func (d stubDict) WordLength() int              { return d.wordLength }
+
+func newStubDict(words ...string) stubDict {
+	guesses := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		guesses[w] = struct{}{}
+	}
+	return stubDict{answers: words, guesses: guesses, wordLength: len(words[0])}
+}
+
+func TestPatternOf(t *testing.T) {
+	evals := []game.Eval{game.Green, game.Yellow, game.Black}
+	if got, want := patternOf(evals), Pattern("GYB"); got != want {
+		t.Errorf("patternOf(%v) = %q, want %q", evals, got, want)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	dict := newStubDict("CRANE", "SLATE", "TRACE", "GRAPE")
+	s := New(dict)
+
+	s.Prune("CRANE", patternOf(game.Score("CRANE", "TRACE")))
+
+	got := s.Candidates()
+	want := []string{"TRACE"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Candidates() = %v, want %v", got, want)
+	}
+}
+
+func TestExpectedRemaining(t *testing.T) {
+	candidates := []string{"CRANE", "TRACE", "GRAPE"}
+
+	// Scoring a candidate against itself always lands in its own bucket
+	// of one, so a guess that perfectly distinguishes every candidate
+	// should have an expected value equal to the candidate count.
+	got := expectedRemaining("CRANE", candidates)
+	if got != len(candidates) {
+		t.Errorf("expectedRemaining(%q, %v) = %d, want %d", "CRANE", candidates, got, len(candidates))
+	}
+
+	// A guess that produces the same pattern against every candidate
+	// narrows nothing: expected value is the square of the full set.
+	same := []string{"AAAAA", "AAAAA"}
+	if got := expectedRemaining("ZZZZZ", same); got != len(same)*len(same) {
+		t.Errorf("expectedRemaining for an indistinguishable guess = %d, want %d", got, len(same)*len(same))
+	}
+}
+
+func TestSuggestPrefersCandidatesOnTies(t *testing.T) {
+	// BAAAA, BBBBB and CCCCC each split the two candidates into buckets
+	// of one, so all three tie; BAAAA sorts first alphabetically but isn't
+	// a candidate, so the candidate BBBBB should be ranked first.
+	dict := stubDict{
+		answers:    []string{"BBBBB", "CCCCC"},
+		guesses:    map[string]struct{}{"BAAAA": {}, "BBBBB": {}, "CCCCC": {}},
+		wordLength: 5,
+	}
+	s := New(dict)
+
+	suggestions := s.Suggest(2)
+	if len(suggestions) != 2 {
+		t.Fatalf("Suggest(2) returned %d suggestions, want 2", len(suggestions))
+	}
+	if suggestions[0].Guess != "BBBBB" {
+		t.Errorf("Suggest(2)[0].Guess = %q, want %q (a candidate, ahead of the tied non-candidate BAAAA)", suggestions[0].Guess, "BBBBB")
+	}
+}
+
+func TestParsePattern(t *testing.T) {
+	s := New(newStubDict("CRANE", "SLATE"))
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{"valid", "bygyb", false},
+		{"wrong length", "byg", true},
+		{"invalid letter", "BYGXB", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.ParsePattern(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParsePattern(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSolveFindsTheAnswer(t *testing.T) {
+	dict := newStubDict("CRANE", "SLATE", "TRACE", "GRAPE", "PLATE")
+	s := New(dict)
+
+	turns := s.Solve("TRACE")
+	if len(turns) == 0 || turns[len(turns)-1].Guess != "TRACE" {
+		t.Fatalf("Solve(%q) = %v, want it to end with the answer", "TRACE", turns)
+	}
+}
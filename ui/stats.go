@@ -0,0 +1,37 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/JeremyLoy/termle/score"
+)
+
+// RenderStats renders a player's history into the usual Wordle
+// played/win-rate/streaks/guess-distribution panel.
+func RenderStats(s score.Stats) string {
+	var b strings.Builder
+	winRate := 0
+	if s.Played > 0 {
+		winRate = s.Wins * 100 / s.Played
+	}
+	fmt.Fprintln(&b, "Statistics")
+	fmt.Fprintf(&b, "Played: %d  Win %%: %d  Current streak: %d  Max streak: %d\n\n",
+		s.Played, winRate, s.CurrentStreak, s.MaxStreak)
+
+	b.WriteString("Guess Distribution\n")
+	max := 1
+	for _, n := range s.Distribution {
+		if n > max {
+			max = n
+		}
+	}
+	for i, n := range s.Distribution {
+		bar := strings.Repeat("█", n*20/max)
+		if n > 0 && bar == "" {
+			bar = "█"
+		}
+		fmt.Fprintf(&b, "%d %s %d\n", i+1, bar, n)
+	}
+	return b.String()
+}
@@ -0,0 +1,283 @@
+// Package ui renders a game.Game as a full-screen Bubble Tea program:
+// letters fill cells as they're typed, and a finished guess flips each
+// tile from black to its final color one at a time.
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/JeremyLoy/termle/game"
+)
+
+const flipDelay = 250 * time.Millisecond
+
+// Model is the Bubble Tea model driving a single game of termle.
+type Model struct {
+	game *game.Game
+	// input holds the letters of the guess currently being typed.
+	input []rune
+	// err is shown below the board until the next keystroke.
+	err string
+	// flipped is how many tiles of the most recently submitted guess have
+	// finished animating into their final color.
+	flipped int
+	// animating is true from the moment a guess is submitted until its
+	// row has finished revealing. While true, submitting another guess is
+	// blocked and flipMsg ticks for any other row are dropped, so a fast
+	// player can't race a stale tick against the row actually animating.
+	animating bool
+	// locked disables guessing, for re-displaying an already-completed
+	// daily game. message is shown below the board in that case.
+	locked  bool
+	message string
+}
+
+// New returns a Model ready to run a game.
+func New(g *game.Game) Model {
+	return Model{game: g}
+}
+
+// Game returns the underlying game state, e.g. so the caller can persist
+// it once the program exits.
+func (m Model) Game() *game.Game {
+	return m.game
+}
+
+// NewFinished returns a read-only Model for a game that has already been
+// completed, e.g. a daily game replayed from history. message is shown
+// below the board in place of the usual input prompt.
+func NewFinished(g *game.Game, message string) Model {
+	return Model{game: g, flipped: len(g.Board[0]), locked: true, message: message}
+}
+
+// flipMsg advances the reveal animation for the guess in row.
+type flipMsg struct{ row int }
+
+func flipTile(row int) tea.Cmd {
+	return tea.Tick(flipDelay, func(time.Time) tea.Msg {
+		return flipMsg{row: row}
+	})
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.updateKey(msg)
+	case flipMsg:
+		if !m.animating || msg.row != m.game.CurrentTurn-1 {
+			// A stale tick from a previous row's animation; drop it.
+			return m, nil
+		}
+		m.flipped++
+		if m.flipped < len(m.game.Board[msg.row]) {
+			return m, flipTile(msg.row)
+		}
+		m.animating = false
+		if m.game.Complete {
+			return m, tea.Quit
+		}
+		return m, nil
+	}
+	return m, nil
+}
+
+func (m Model) updateKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.locked {
+		if msg.Type == tea.KeyCtrlC || msg.Type == tea.KeyEnter {
+			return m, tea.Quit
+		}
+		return m, nil
+	}
+	m.err = ""
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyBackspace:
+		if len(m.input) > 0 {
+			m.input = m.input[:len(m.input)-1]
+		}
+		return m, nil
+	case tea.KeyEnter:
+		return m.submitGuess()
+	case tea.KeyRunes:
+		if len(m.input) < m.game.WordLength {
+			m.input = append(m.input, msg.Runes...)
+		}
+		return m, nil
+	}
+	return m, nil
+}
+
+func (m Model) submitGuess() (tea.Model, tea.Cmd) {
+	if m.animating {
+		// Previous guess's reveal is still playing; ignore Enter until it
+		// finishes so its flipMsg ticks can't race the next guess's.
+		return m, nil
+	}
+	guess := strings.ToUpper(string(m.input))
+	if !m.game.Valid.MatchString(guess) {
+		m.err = fmt.Sprintf("Please enter a %d letter word", m.game.WordLength)
+		return m, nil
+	}
+	if _, ok := m.game.ValidGuesses[guess]; !ok {
+		m.err = "Not in word list"
+		return m, nil
+	}
+	if m.game.AlreadyGuessed(guess) {
+		m.err = fmt.Sprintf("you already guessed %s", guess)
+		return m, nil
+	}
+	if m.game.HardMode {
+		if err := m.game.CheckHints(guess); err != nil {
+			m.err = fmt.Sprintf("Hard Mode: %s", err.Error())
+			return m, nil
+		}
+	}
+	row := m.game.CurrentTurn
+	m.game.AddGuess(guess)
+	m.input = nil
+	m.flipped = 0
+	m.animating = true
+	return m, flipTile(row)
+}
+
+func (m Model) View() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Wordle %v\n", m.game.Day)
+	for row, cells := range m.game.Board {
+		for col, cell := range cells {
+			b.WriteString(" " + m.renderCell(row, col, cell))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(keyboard(m.game))
+	if m.err != "" {
+		b.WriteString(m.err + "\n")
+	}
+	if m.message != "" {
+		b.WriteString(m.message + "\n")
+	}
+	if m.game.Complete {
+		b.WriteString(shareableScore(m.game))
+	}
+	return b.String()
+}
+
+// keyboardRows is a three-row QWERTY layout for the at-a-glance keyboard
+// hint below the board. It only covers A-Z; accented letters from
+// non-English dictionaries are appended as an extra row by keyboard, since
+// their position on a physical keyboard varies by layout.
+var keyboardRows = [3]string{"QWERTYUIOP", "ASDFGHJKL", "ZXCVBNM"}
+
+func keyboard(g *game.Game) string {
+	var b strings.Builder
+	shown := make(map[rune]bool)
+	for _, row := range keyboardRows {
+		for _, r := range row {
+			shown[r] = true
+			if eval, ok := g.Keyboard[r]; ok {
+				b.WriteString(colorFor(eval)(string(r)))
+			} else {
+				b.WriteString(white(string(r)))
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	var extra []rune
+	for r := range g.Keyboard {
+		if !shown[r] {
+			extra = append(extra, r)
+		}
+	}
+	if len(extra) > 0 {
+		sort.Slice(extra, func(i, j int) bool { return extra[i] < extra[j] })
+		for _, r := range extra {
+			b.WriteString(colorFor(g.Keyboard[r])(string(r)))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// renderCell draws the letter currently being typed into the active row
+// even though it hasn't been scored yet, and keeps the most recent guess
+// black until its tiles have flipped in sequence.
+func (m Model) renderCell(row, col int, cell game.Cell) string {
+	if row == m.game.CurrentTurn && m.game.CurrentTurn < len(m.game.Board) {
+		if col < len(m.input) {
+			return white(string(m.input[col]))
+		}
+		return black("_")
+	}
+	if row == m.game.CurrentTurn-1 && col >= m.flipped {
+		return black(cell.Letter)
+	}
+	return colorFor(cell.Eval)(cell.Letter)
+}
+
+func colorFor(e game.Eval) func(string) string {
+	switch e {
+	case game.Green:
+		return green
+	case game.Yellow:
+		return yellow
+	default:
+		return black
+	}
+}
+
+func green(l string) string {
+	return "\033[37;102m" + l + "\033[0m"
+}
+func yellow(l string) string {
+	return "\033[37;103m" + l + "\033[0m"
+}
+func white(l string) string {
+	return "\033[0;107m" + l + "\033[0m"
+}
+func black(l string) string {
+	return "\033[37;100m" + l + "\033[0m"
+}
+
+func shareableScore(g *game.Game) string {
+	var b strings.Builder
+	var turnS string
+	if g.Won {
+		turnS = strconv.Itoa(g.CurrentTurn)
+		b.WriteString("you won!\n")
+	} else {
+		turnS = "X"
+		b.WriteString("you lose!\n")
+		fmt.Fprintf(&b, "Answer was %s\n", g.Answer)
+	}
+	fmt.Fprintf(&b, "Wordle %v %v/6\n\n", g.Day, turnS)
+	for i := 0; i < g.CurrentTurn; i++ {
+		for _, cell := range g.Board[i] {
+			b.WriteString(squareFor(cell.Eval))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func squareFor(e game.Eval) string {
+	switch e {
+	case game.Green:
+		return `🟩`
+	case game.Yellow:
+		return `🟨`
+	default:
+		return `⬛`
+	}
+}